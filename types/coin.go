@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
-	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -12,7 +11,25 @@ import (
 
 type Coin struct {
 	Denom  string `json:"denom"`
-	Amount int64  `json:"amount"`
+	Amount Int    `json:"amount"`
+}
+
+// NewCoin returns a new Coin, panicking if amount is negative or denom is
+// invalid.
+func NewCoin(denom string, amount Int) Coin {
+	if amount.IsNegative() {
+		panic(fmt.Sprintf("negative coin amount: %v", amount))
+	}
+	if err := ValidateDenom(denom); err != nil {
+		panic(err)
+	}
+	return Coin{Denom: denom, Amount: amount}
+}
+
+// NewInt64Coin returns a new Coin from an int64 amount, panicking if amount
+// is negative.
+func NewInt64Coin(denom string, amount int64) Coin {
+	return NewCoin(denom, NewInt(amount))
 }
 
 func (coin Coin) String() string {
@@ -20,10 +37,12 @@ func (coin Coin) String() string {
 }
 
 //regex codes for extracting coins from string
-var reDenom = regexp.MustCompile("")
 var reAmt = regexp.MustCompile("(\\d+)")
 
-var reCoin = regexp.MustCompile("^([[:digit:]]+)[[:space:]]*([[:alpha:]]+)$")
+// reCoin captures the amount and the raw denom candidate; the denom itself
+// is validated separately via ValidateDenom so callers get a descriptive
+// error rather than just "no match".
+var reCoin = regexp.MustCompile("^([[:digit:]]+)[[:space:]]*([a-zA-Z0-9/:._-]+)$")
 
 func ParseCoin(str string) (Coin, error) {
 	var coin Coin
@@ -34,15 +53,42 @@ func ParseCoin(str string) (Coin, error) {
 	}
 
 	// parse the amount (should always parse properly)
-	amt, err := strconv.Atoi(matches[1])
-	if err != nil {
+	amt, ok := NewIntFromString(matches[1])
+	if !ok {
+		return coin, errors.Errorf("%s is not a valid coin amount", matches[1])
+	}
+
+	denom := matches[2]
+	if err := ValidateDenom(denom); err != nil {
 		return coin, err
 	}
 
-	coin = Coin{matches[2], int64(amt)}
+	coin = Coin{denom, amt}
 	return coin, nil
 }
 
+// Validate returns an error if the coin's denom is invalid or its amount is
+// negative.
+func (coin Coin) Validate() error {
+	if err := ValidateDenom(coin.Denom); err != nil {
+		return err
+	}
+	if coin.Amount.IsNegative() {
+		return errors.Errorf("negative coin amount: %v", coin.Amount)
+	}
+	return nil
+}
+
+// Validate returns an error if any coin in coins fails Coin.Validate.
+func (coins Coins) Validate() error {
+	for _, coin := range coins {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 //----------------------------------------
 
 type Coins []Coin
@@ -91,14 +137,14 @@ func (coins Coins) IsValid() bool {
 	case 0:
 		return true
 	case 1:
-		return coins[0].Amount != 0
+		return !coins[0].Amount.IsZero()
 	default:
 		lowDenom := coins[0].Denom
 		for _, coin := range coins[1:] {
 			if coin.Denom <= lowDenom {
 				return false
 			}
-			if coin.Amount == 0 {
+			if coin.Amount.IsZero() {
 				return false
 			}
 			// we compare each coin against the last denom
@@ -130,12 +176,13 @@ func (coinsA Coins) Plus(coinsB Coins) Coins {
 			sum = append(sum, coinA)
 			indexA += 1
 		case 0:
-			if coinA.Amount+coinB.Amount == 0 {
+			sumAmount := coinA.Amount.Add(coinB.Amount)
+			if sumAmount.IsZero() {
 				// ignore 0 sum coin type
 			} else {
 				sum = append(sum, Coin{
 					Denom:  coinA.Denom,
-					Amount: coinA.Amount + coinB.Amount,
+					Amount: sumAmount,
 				})
 			}
 			indexA += 1
@@ -153,18 +200,117 @@ func (coins Coins) Negative() Coins {
 	for _, coin := range coins {
 		res = append(res, Coin{
 			Denom:  coin.Denom,
-			Amount: -coin.Amount,
+			Amount: coin.Amount.Neg(),
 		})
 	}
 	return res
 }
 
+// Minus subtracts coinsB from coinsA, panicking if the result contains a
+// negative amount. Use SafeSub if a negative result is possible and should
+// be handled rather than crash.
 func (coinsA Coins) Minus(coinsB Coins) Coins {
-	return coinsA.Plus(coinsB.Negative())
+	diff, negative := coinsA.SafeSub(coinsB)
+	if negative {
+		panic("negative coin amount")
+	}
+	return diff
+}
+
+// SafeSub subtracts coinsB from coinsA, returning the difference and
+// whether the result went negative. Unlike Minus, it never panics.
+func (coinsA Coins) SafeSub(coinsB Coins) (Coins, bool) {
+	diff := coinsA.Plus(coinsB.Negative())
+	return diff, !diff.IsNonnegative()
+}
+
+// maxBitLen bounds the size of Coins amounts accepted by SafeAdd, guarding
+// against absurdly large results even though amounts are arbitrary
+// precision.
+const maxBitLen = 256
+
+// SafeAdd adds coinsB to coinsA, returning an error instead of an
+// unboundedly large result if any resulting amount would exceed maxBitLen
+// bits.
+func (coinsA Coins) SafeAdd(coinsB Coins) (Coins, error) {
+	sum := coinsA.Plus(coinsB)
+	for _, coin := range sum {
+		if coin.Amount.BigInt().BitLen() > maxBitLen {
+			return nil, errors.Errorf("coin amount for %s overflows %d bits", coin.Denom, maxBitLen)
+		}
+	}
+	return sum, nil
+}
+
+// Max returns, for every denom present in either coinsA or coinsB, the
+// larger of the two amounts (a missing denom is treated as zero).
+func (coinsA Coins) Max(coinsB Coins) Coins {
+	return coinsA.combine(coinsB, func(a, b Int) Int {
+		if a.GT(b) {
+			return a
+		}
+		return b
+	})
+}
+
+// Min returns, for every denom present in either coinsA or coinsB, the
+// smaller of the two amounts (a missing denom is treated as zero). This is
+// used to clamp against an available balance, e.g. in slashing/vesting.
+func (coinsA Coins) Min(coinsB Coins) Coins {
+	return coinsA.combine(coinsB, func(a, b Int) Int {
+		if a.LT(b) {
+			return a
+		}
+		return b
+	})
+}
+
+// combine merges coinsA and coinsB denom-by-denom using op, treating a
+// denom missing from one side as a zero amount, and dropping any result
+// that comes out to zero.
+func (coinsA Coins) combine(coinsB Coins, op func(a, b Int) Int) Coins {
+	sum := []Coin{}
+	indexA, indexB := 0, 0
+	lenA, lenB := len(coinsA), len(coinsB)
+	for indexA < lenA || indexB < lenB {
+		switch {
+		case indexA == lenA:
+			sum = appendNonzero(sum, coinsB[indexB].Denom, op(ZeroInt(), coinsB[indexB].Amount))
+			indexB += 1
+		case indexB == lenB:
+			sum = appendNonzero(sum, coinsA[indexA].Denom, op(coinsA[indexA].Amount, ZeroInt()))
+			indexA += 1
+		default:
+			coinA, coinB := coinsA[indexA], coinsB[indexB]
+			switch strings.Compare(coinA.Denom, coinB.Denom) {
+			case -1:
+				sum = appendNonzero(sum, coinA.Denom, op(coinA.Amount, ZeroInt()))
+				indexA += 1
+			case 0:
+				sum = appendNonzero(sum, coinA.Denom, op(coinA.Amount, coinB.Amount))
+				indexA += 1
+				indexB += 1
+			case 1:
+				sum = appendNonzero(sum, coinB.Denom, op(ZeroInt(), coinB.Amount))
+				indexB += 1
+			}
+		}
+	}
+	return sum
+}
+
+func appendNonzero(coins []Coin, denom string, amount Int) []Coin {
+	if amount.IsZero() {
+		return coins
+	}
+	return append(coins, Coin{Denom: denom, Amount: amount})
 }
 
 func (coinsA Coins) IsGTE(coinsB Coins) bool {
-	diff := coinsA.Minus(coinsB)
+	diff, negative := coinsA.SafeSub(coinsB)
+	if negative {
+		return false
+	}
 	if len(diff) == 0 {
 		return true
 	}
@@ -180,7 +326,7 @@ func (coinsA Coins) IsEqual(coinsB Coins) bool {
 		return false
 	}
 	for i := 0; i < len(coinsA); i++ {
-		if coinsA[i] != coinsB[i] {
+		if coinsA[i].Denom != coinsB[i].Denom || !coinsA[i].Amount.Equal(coinsB[i].Amount) {
 			return false
 		}
 	}
@@ -192,7 +338,7 @@ func (coins Coins) IsPositive() bool {
 		return false
 	}
 	for _, coinAmount := range coins {
-		if coinAmount.Amount <= 0 {
+		if !coinAmount.Amount.IsPositive() {
 			return false
 		}
 	}
@@ -204,13 +350,167 @@ func (coins Coins) IsNonnegative() bool {
 		return true
 	}
 	for _, coinAmount := range coins {
-		if coinAmount.Amount < 0 {
+		if coinAmount.Amount.IsNegative() {
+			return false
+		}
+	}
+	return true
+}
+
+// AmountOf returns the amount of coins with the given denom, or zero if the
+// denom is not present. Coins is kept sorted by denom, so this runs in
+// O(log n) via binary search rather than a linear scan.
+func (coins Coins) AmountOf(denom string) Int {
+	switch len(coins) {
+	case 0:
+		return ZeroInt()
+	case 1:
+		coin := coins[0]
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+		return ZeroInt()
+	default:
+		midIdx := len(coins) / 2
+		coin := coins[midIdx]
+		switch {
+		case denom < coin.Denom:
+			return coins[:midIdx].AmountOf(denom)
+		case denom == coin.Denom:
+			return coin.Amount
+		default:
+			return coins[midIdx+1:].AmountOf(denom)
+		}
+	}
+}
+
+// DenomsSubsetOf returns true if every denom in coins is also present in
+// other, regardless of amounts.
+func (coins Coins) DenomsSubsetOf(other Coins) bool {
+	if len(coins) > len(other) {
+		return false
+	}
+	for _, coin := range coins {
+		if other.AmountOf(coin.Denom).IsZero() {
 			return false
 		}
 	}
 	return true
 }
 
+// ContainsDenomsOf returns true if coins has an entry for every denom
+// present in other, regardless of amounts.
+func (coins Coins) ContainsDenomsOf(other Coins) bool {
+	return other.DenomsSubsetOf(coins)
+}
+
+// MulInt returns a new Coins with every amount multiplied by x, dropping
+// any resulting zero amounts (x == 0 therefore yields an empty Coins,
+// matching the zero-amount invariant the rest of this file maintains).
+func (coins Coins) MulInt(x int64) Coins {
+	multiplier := NewInt(x)
+	res := make(Coins, 0, len(coins))
+	for _, coin := range coins {
+		product := coin.Amount.Mul(multiplier)
+		if product.IsZero() {
+			continue
+		}
+		res = append(res, Coin{Denom: coin.Denom, Amount: product})
+	}
+	return res
+}
+
+// QuoInt returns a new Coins with every amount divided by x (integer
+// division truncated toward zero), dropping any resulting zero amounts. x
+// must be positive: QuoInt panics if x <= 0, since zero and negative
+// divisors have no sensible per-coin quotient.
+func (coins Coins) QuoInt(x int64) Coins {
+	if x <= 0 {
+		panic(fmt.Sprintf("QuoInt: divisor must be positive, got %d", x))
+	}
+	divisor := NewInt(x)
+	res := make(Coins, 0, len(coins))
+	for _, coin := range coins {
+		quo := coin.Amount.Quo(divisor)
+		if quo.IsZero() {
+			continue
+		}
+		res = append(res, Coin{Denom: coin.Denom, Amount: quo})
+	}
+	return res
+}
+
+// SplitProportional distributes coins across len(weights) recipients
+// proportionally to weights, using the largest-remainder method: floor
+// shares are allocated first, then the leftover unit-by-unit goes to the
+// recipients with the largest fractional remainders (ties broken by
+// index). This keeps the split deterministic across nodes and loses no
+// dust, unlike naive per-recipient truncation.
+func (coins Coins) SplitProportional(weights []int64) []Coins {
+	result := make([]Coins, len(weights))
+	if len(weights) == 0 {
+		return result
+	}
+
+	totalWeight := int64(0)
+	for _, w := range weights {
+		totalWeight += w
+	}
+	if totalWeight == 0 {
+		return result
+	}
+	totalWeightInt := NewInt(totalWeight)
+
+	for _, coin := range coins {
+		shares := make([]Int, len(weights))
+		remainders := make([]Int, len(weights))
+		allocated := ZeroInt()
+
+		for i, w := range weights {
+			product := coin.Amount.Mul(NewInt(w))
+			share := product.Quo(totalWeightInt)
+			remainder := product.Sub(share.Mul(totalWeightInt))
+			// Quo truncates toward zero; step down to the floor when the
+			// remainder's sign differs from the divisor's.
+			if !remainder.IsZero() && remainder.IsNegative() != totalWeightInt.IsNegative() {
+				share = share.Sub(NewInt(1))
+				remainder = remainder.Add(totalWeightInt)
+			}
+			remainders[i] = remainder
+			shares[i] = share
+			allocated = allocated.Add(share)
+		}
+
+		leftover := coin.Amount.Sub(allocated).Int64()
+
+		order := make([]int, len(weights))
+		for i := range order {
+			order[i] = i
+		}
+		sort.SliceStable(order, func(a, b int) bool {
+			return remainders[order[a]].GT(remainders[order[b]])
+		})
+
+		for i := int64(0); i < leftover; i++ {
+			idx := order[i]
+			shares[idx] = shares[idx].Add(NewInt(1))
+		}
+
+		for i, share := range shares {
+			if share.IsZero() {
+				continue
+			}
+			result[i] = append(result[i], Coin{Denom: coin.Denom, Amount: share})
+		}
+	}
+
+	for i := range result {
+		result[i].Sort()
+	}
+
+	return result
+}
+
 /*** Implement Sort interface ***/
 
 func (c Coins) Len() int           { return len(c) }
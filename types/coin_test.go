@@ -0,0 +1,457 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewCoin(t *testing.T) {
+	coin := NewCoin("atom", NewInt(10))
+	if coin.Denom != "atom" || !coin.Amount.Equal(NewInt(10)) {
+		t.Errorf("NewCoin(atom, 10) = %v", coin)
+	}
+}
+
+func TestNewCoinPanicsOnNegativeAmount(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected NewCoin to panic on a negative amount")
+		}
+	}()
+	NewCoin("atom", NewInt(-1))
+}
+
+func TestNewInt64Coin(t *testing.T) {
+	coin := NewInt64Coin("atom", 10)
+	if coin.Denom != "atom" || !coin.Amount.Equal(NewInt(10)) {
+		t.Errorf("NewInt64Coin(atom, 10) = %v", coin)
+	}
+}
+
+func TestParseCoin(t *testing.T) {
+	cases := []struct {
+		input   string
+		denom   string
+		amount  int64
+		wantErr bool
+	}{
+		{"10atom", "atom", 10, false},
+		{"  10  atom  ", "atom", 10, false},
+		{"0atom", "atom", 0, false},
+		{"10ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 10, false},
+		{"not-a-coin", "", 0, true},
+		{"atom", "", 0, true},
+		{"-10atom", "", 0, true},
+	}
+
+	for _, tc := range cases {
+		coin, err := ParseCoin(tc.input)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseCoin(%q): expected error, got %v", tc.input, coin)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseCoin(%q): unexpected error %v", tc.input, err)
+			continue
+		}
+		if coin.Denom != tc.denom || !coin.Amount.Equal(NewInt(tc.amount)) {
+			t.Errorf("ParseCoin(%q) = %v, want %s%d", tc.input, coin, tc.denom, tc.amount)
+		}
+	}
+}
+
+func TestCoinJSONRoundTrip(t *testing.T) {
+	coin := NewInt64Coin("atom", 1234567890)
+	bz, err := json.Marshal(coin)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got Coin
+	if err := json.Unmarshal(bz, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if got.Denom != coin.Denom || !got.Amount.Equal(coin.Amount) {
+		t.Errorf("round trip %v -> %s -> %v", coin, bz, got)
+	}
+}
+
+func TestCoinValidate(t *testing.T) {
+	if err := (Coin{Denom: "atom", Amount: NewInt(10)}).Validate(); err != nil {
+		t.Errorf("valid coin rejected: %v", err)
+	}
+	if err := (Coin{Denom: "a", Amount: NewInt(10)}).Validate(); err == nil {
+		t.Errorf("expected error for too-short denom")
+	}
+	if err := (Coin{Denom: "atom", Amount: NewInt(-10)}).Validate(); err == nil {
+		t.Errorf("expected error for negative amount")
+	}
+}
+
+func mustParseCoins(t *testing.T, s string) Coins {
+	t.Helper()
+	coins, err := ParseCoins(s)
+	if err != nil {
+		t.Fatalf("ParseCoins(%q): %v", s, err)
+	}
+	return coins
+}
+
+func TestCoinsPlusMinusIdentities(t *testing.T) {
+	a := mustParseCoins(t, "10atom,5btc")
+	b := mustParseCoins(t, "3atom,5btc")
+
+	sum := a.Plus(b)
+	if sum.AmountOf("atom").Int64() != 13 {
+		t.Errorf("10atom + 3atom = %v", sum)
+	}
+	if sum.AmountOf("btc").Int64() != 10 {
+		t.Errorf("5btc + 5btc = %v, want 10btc", sum)
+	}
+
+	// a - a should be empty/zero.
+	if diff := a.Minus(a); len(diff) != 0 {
+		t.Errorf("a - a = %v, want empty", diff)
+	}
+
+	// (a + b) - b should equal a.
+	roundTrip := a.Plus(b).Minus(b)
+	if !roundTrip.IsEqual(a) {
+		t.Errorf("(a + b) - b = %v, want %v", roundTrip, a)
+	}
+}
+
+func TestCoinsMinusPanicsOnNegativeResult(t *testing.T) {
+	small := mustParseCoins(t, "1atom")
+	large := mustParseCoins(t, "10atom")
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected Minus to panic when the result goes negative")
+		}
+	}()
+	small.Minus(large)
+}
+
+func TestCoinsIsGTE(t *testing.T) {
+	small := mustParseCoins(t, "5atom")
+	large := mustParseCoins(t, "10atom")
+
+	if small.IsGTE(large) {
+		t.Errorf("5atom.IsGTE(10atom) = true, want false")
+	}
+	if !large.IsGTE(small) {
+		t.Errorf("10atom.IsGTE(5atom) = false, want true")
+	}
+	if !small.IsGTE(small) {
+		t.Errorf("5atom.IsGTE(5atom) = false, want true")
+	}
+}
+
+func TestCoinsValidate(t *testing.T) {
+	valid := mustParseCoins(t, "5atom,3btc")
+	if err := valid.Validate(); err != nil {
+		t.Errorf("valid coins rejected: %v", err)
+	}
+
+	invalid := Coins{{Denom: "a", Amount: NewInt(1)}}
+	if err := invalid.Validate(); err == nil {
+		t.Errorf("expected error for invalid denom")
+	}
+}
+
+func TestCoinsIsGTERegressionDoesNotPanic(t *testing.T) {
+	small := mustParseCoins(t, "5atom")
+	large := mustParseCoins(t, "10atom")
+
+	// Before the fix, IsGTE routed through the panicking Minus and crashed
+	// here instead of returning false.
+	if small.IsGTE(large) {
+		t.Errorf("5atom.IsGTE(10atom) = true, want false")
+	}
+}
+
+func TestCoinsSafeSub(t *testing.T) {
+	small := mustParseCoins(t, "5atom")
+	large := mustParseCoins(t, "10atom")
+
+	diff, negative := large.SafeSub(small)
+	if negative {
+		t.Errorf("10atom - 5atom should not go negative")
+	}
+	if diff.AmountOf("atom").Int64() != 5 {
+		t.Errorf("10atom - 5atom = %v, want 5atom", diff)
+	}
+
+	diff, negative = small.SafeSub(large)
+	if !negative {
+		t.Errorf("5atom - 10atom should go negative")
+	}
+	if diff.AmountOf("atom").Int64() != -5 {
+		t.Errorf("5atom - 10atom = %v, want -5atom", diff)
+	}
+}
+
+func TestCoinsSafeAdd(t *testing.T) {
+	a := mustParseCoins(t, "5atom")
+	b := mustParseCoins(t, "3atom")
+
+	sum, err := a.SafeAdd(b)
+	if err != nil {
+		t.Fatalf("SafeAdd: unexpected error %v", err)
+	}
+	if sum.AmountOf("atom").Int64() != 8 {
+		t.Errorf("5atom + 3atom = %v, want 8atom", sum)
+	}
+
+	overflow, ok := NewIntFromString("1" + stringsRepeat("0", 100))
+	if !ok {
+		t.Fatal("expected to parse a 100-digit number")
+	}
+	huge := Coins{Coin{Denom: "atom", Amount: overflow}}
+	if _, err := huge.SafeAdd(huge); err == nil {
+		t.Errorf("expected SafeAdd to reject an amount beyond maxBitLen")
+	}
+}
+
+func stringsRepeat(s string, n int) string {
+	out := ""
+	for i := 0; i < n; i++ {
+		out += s
+	}
+	return out
+}
+
+func TestCoinsMaxMin(t *testing.T) {
+	a := mustParseCoins(t, "5atom,10btc")
+	b := mustParseCoins(t, "8atom,2btc,1eth")
+
+	max := a.Max(b)
+	if max.AmountOf("atom").Int64() != 8 {
+		t.Errorf("Max(5atom) vs 8atom = %v", max)
+	}
+	if max.AmountOf("btc").Int64() != 10 {
+		t.Errorf("Max(10btc) vs 2btc = %v", max)
+	}
+	if max.AmountOf("eth").Int64() != 1 {
+		t.Errorf("Max should include eth present only in b, got %v", max)
+	}
+
+	min := a.Min(b)
+	if min.AmountOf("atom").Int64() != 5 {
+		t.Errorf("Min(5atom) vs 8atom = %v", min)
+	}
+	if min.AmountOf("btc").Int64() != 2 {
+		t.Errorf("Min(10btc) vs 2btc = %v", min)
+	}
+	if !min.AmountOf("eth").IsZero() {
+		t.Errorf("Min against a missing denom (treated as zero) should drop eth, got %v", min)
+	}
+}
+
+func TestCoinsAmountOf(t *testing.T) {
+	var empty Coins
+	if !empty.AmountOf("atom").IsZero() {
+		t.Errorf("AmountOf on empty Coins should be zero")
+	}
+
+	single := mustParseCoins(t, "5atom")
+	if single.AmountOf("atom").Int64() != 5 {
+		t.Errorf("AmountOf(atom) on single-coin Coins = %v, want 5", single.AmountOf("atom"))
+	}
+	if !single.AmountOf("btc").IsZero() {
+		t.Errorf("AmountOf(btc) on single-coin Coins missing btc should be zero")
+	}
+
+	multi := mustParseCoins(t, "5atom,10btc,1eth")
+	if multi.AmountOf("atom").Int64() != 5 {
+		t.Errorf("AmountOf(atom) = %v, want 5", multi.AmountOf("atom"))
+	}
+	if multi.AmountOf("btc").Int64() != 10 {
+		t.Errorf("AmountOf(btc) = %v, want 10", multi.AmountOf("btc"))
+	}
+	if multi.AmountOf("eth").Int64() != 1 {
+		t.Errorf("AmountOf(eth) = %v, want 1", multi.AmountOf("eth"))
+	}
+	if !multi.AmountOf("xrp").IsZero() {
+		t.Errorf("AmountOf on a non-present denom should be zero")
+	}
+}
+
+func TestCoinsDenomsSubsetOf(t *testing.T) {
+	var empty Coins
+	full := mustParseCoins(t, "5atom,10btc")
+
+	if !empty.DenomsSubsetOf(full) {
+		t.Errorf("empty Coins should be a denom subset of anything")
+	}
+	if !full.DenomsSubsetOf(full) {
+		t.Errorf("Coins should be a denom subset of itself")
+	}
+
+	subset := mustParseCoins(t, "5atom")
+	if !subset.DenomsSubsetOf(full) {
+		t.Errorf("%v should be a denom subset of %v", subset, full)
+	}
+	if full.DenomsSubsetOf(subset) {
+		t.Errorf("%v should not be a denom subset of %v", full, subset)
+	}
+
+	disjoint := mustParseCoins(t, "1eth")
+	if disjoint.DenomsSubsetOf(full) {
+		t.Errorf("%v should not be a denom subset of %v", disjoint, full)
+	}
+}
+
+func TestCoinsContainsDenomsOf(t *testing.T) {
+	full := mustParseCoins(t, "5atom,10btc")
+	subset := mustParseCoins(t, "5atom")
+
+	if !full.ContainsDenomsOf(subset) {
+		t.Errorf("%v should contain all denoms of %v", full, subset)
+	}
+	if subset.ContainsDenomsOf(full) {
+		t.Errorf("%v should not contain all denoms of %v", subset, full)
+	}
+}
+
+func TestCoinsMulInt(t *testing.T) {
+	coins := mustParseCoins(t, "5atom,3btc")
+
+	tripled := coins.MulInt(3)
+	if tripled.AmountOf("atom").Int64() != 15 || tripled.AmountOf("btc").Int64() != 9 {
+		t.Errorf("MulInt(3) = %v", tripled)
+	}
+
+	zeroed := coins.MulInt(0)
+	if len(zeroed) != 0 {
+		t.Errorf("MulInt(0) = %v, want empty Coins (no zero-amount entries)", zeroed)
+	}
+	if !zeroed.IsValid() {
+		t.Errorf("MulInt(0) result should satisfy the Coins zero-amount invariant")
+	}
+
+	negated := coins.MulInt(-1)
+	if negated.AmountOf("atom").Int64() != -5 {
+		t.Errorf("MulInt(-1) = %v", negated)
+	}
+}
+
+func TestCoinsQuoInt(t *testing.T) {
+	coins := mustParseCoins(t, "10atom,3btc")
+
+	halved := coins.QuoInt(2)
+	if halved.AmountOf("atom").Int64() != 5 {
+		t.Errorf("10atom QuoInt(2) = %v, want 5atom", halved)
+	}
+	// 3 / 2 = 1 (truncated), not dropped since it's nonzero.
+	if halved.AmountOf("btc").Int64() != 1 {
+		t.Errorf("3btc QuoInt(2) = %v, want 1btc", halved)
+	}
+
+	// A quotient that truncates to zero is dropped, not kept as 0btc.
+	tiny := mustParseCoins(t, "1btc")
+	divided := tiny.QuoInt(2)
+	if len(divided) != 0 {
+		t.Errorf("1btc QuoInt(2) = %v, want empty (zero amounts dropped)", divided)
+	}
+}
+
+func TestCoinsQuoIntPanicsOnNonPositiveDivisor(t *testing.T) {
+	coins := mustParseCoins(t, "10atom")
+
+	for _, x := range []int64{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("expected QuoInt(%d) to panic", x)
+				}
+			}()
+			coins.QuoInt(x)
+		}()
+	}
+}
+
+func TestCoinsSplitProportionalEvenSplit(t *testing.T) {
+	coins := mustParseCoins(t, "90atom")
+	shares := coins.SplitProportional([]int64{1, 1, 1})
+
+	if len(shares) != 3 {
+		t.Fatalf("expected 3 shares, got %d", len(shares))
+	}
+	for i, share := range shares {
+		if share.AmountOf("atom").Int64() != 30 {
+			t.Errorf("share[%d] = %v, want 30atom", i, share)
+		}
+	}
+}
+
+func TestCoinsSplitProportionalLargestRemainder(t *testing.T) {
+	// 100 split 1:1:1 cannot divide evenly: floor shares are 33,33,33
+	// summing to 99, leaving 1 unit for the remainder method to place.
+	coins := mustParseCoins(t, "100atom")
+	shares := coins.SplitProportional([]int64{1, 1, 1})
+
+	total := int64(0)
+	for _, share := range shares {
+		total += share.AmountOf("atom").Int64()
+	}
+	if total != 100 {
+		t.Errorf("shares should sum back to the original amount, got %d, want 100", total)
+	}
+
+	// Every weight is tied, so the leftover unit goes to the lowest index.
+	if shares[0].AmountOf("atom").Int64() != 34 {
+		t.Errorf("shares[0] = %v, want 34atom (tie-break by index)", shares[0])
+	}
+	if shares[1].AmountOf("atom").Int64() != 33 || shares[2].AmountOf("atom").Int64() != 33 {
+		t.Errorf("shares[1:] = %v, %v, want 33atom each", shares[1], shares[2])
+	}
+}
+
+func TestCoinsSplitProportionalWeighted(t *testing.T) {
+	coins := mustParseCoins(t, "100atom")
+	shares := coins.SplitProportional([]int64{1, 2, 3})
+
+	want := []int64{17, 33, 50}
+	total := int64(0)
+	for i, share := range shares {
+		got := share.AmountOf("atom").Int64()
+		total += got
+		if got != want[i] {
+			t.Errorf("shares[%d] = %d, want %d", i, got, want[i])
+		}
+	}
+	if total != 100 {
+		t.Errorf("shares should sum to 100, got %d", total)
+	}
+}
+
+func TestCoinsSplitProportionalZeroAndNegativeWeights(t *testing.T) {
+	coins := mustParseCoins(t, "10atom")
+
+	// All-zero weights: total weight is zero, so no shares are assigned
+	// rather than dividing by zero.
+	shares := coins.SplitProportional([]int64{0, 0})
+	if len(shares) != 2 || len(shares[0]) != 0 || len(shares[1]) != 0 {
+		t.Errorf("SplitProportional with zero weights = %v, want two empty shares", shares)
+	}
+
+	// No recipients at all.
+	if shares := coins.SplitProportional(nil); len(shares) != 0 {
+		t.Errorf("SplitProportional(nil) = %v, want no shares", shares)
+	}
+
+	// A negative weight offsets a positive one, still summing back to the
+	// original amount as long as the total weight stays positive.
+	shares = coins.SplitProportional([]int64{3, -1, 2})
+	total := int64(0)
+	for _, share := range shares {
+		total += share.AmountOf("atom").Int64()
+	}
+	if total != 10 {
+		t.Errorf("shares should sum back to 10, got %d (shares=%v)", total, shares)
+	}
+}
@@ -0,0 +1,299 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DecCoin is a Coin-like amount that keeps a fractional remainder, for
+// proportional accounting (validator commissions, staking rewards, LP
+// shares) where truncation must be deferred until payout.
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount Dec    `json:"amount"`
+}
+
+// NewDecCoin constructs a DecCoin from a whole-number Int amount, panicking
+// if denom is invalid.
+func NewDecCoin(denom string, amount Int) DecCoin {
+	if err := ValidateDenom(denom); err != nil {
+		panic(err)
+	}
+	return DecCoin{Denom: denom, Amount: NewDecFromInt(amount)}
+}
+
+// NewDecCoinFromDec constructs a DecCoin from a Dec amount, panicking if
+// denom is invalid.
+func NewDecCoinFromDec(denom string, amount Dec) DecCoin {
+	if err := ValidateDenom(denom); err != nil {
+		panic(err)
+	}
+	return DecCoin{Denom: denom, Amount: amount}
+}
+
+// NewDecCoinFromCoin converts a Coin into a DecCoin with a zero fractional
+// part.
+func NewDecCoinFromCoin(coin Coin) DecCoin {
+	return DecCoin{Denom: coin.Denom, Amount: NewDecFromInt(coin.Amount)}
+}
+
+func (coin DecCoin) String() string {
+	return fmt.Sprintf("%v%v", coin.Amount, coin.Denom)
+}
+
+// reDecCoin captures the amount and the raw denom candidate; the denom
+// itself is validated separately via ValidateDenom so callers get a
+// descriptive error and so DecCoin accepts the same denom styles as Coin
+// (IBC paths, factory denoms, etc).
+var reDecCoin = regexp.MustCompile(`^(\d+(\.\d+)?)[[:space:]]*([a-zA-Z0-9/:._-]+)$`)
+
+// ParseDecCoin parses a single "<amount><denom>" string, e.g. "1.5atom".
+func ParseDecCoin(str string) (DecCoin, error) {
+	var coin DecCoin
+
+	matches := reDecCoin.FindStringSubmatch(strings.TrimSpace(str))
+	if matches == nil {
+		return coin, errors.Errorf("%s is invalid decimal coin definition", str)
+	}
+
+	amt, err := NewDecFromStr(matches[1])
+	if err != nil {
+		return coin, err
+	}
+
+	denom := matches[3]
+	if err := ValidateDenom(denom); err != nil {
+		return coin, err
+	}
+
+	coin = DecCoin{denom, amt}
+	return coin, nil
+}
+
+// Validate returns an error if the coin's denom is invalid.
+func (coin DecCoin) Validate() error {
+	return ValidateDenom(coin.Denom)
+}
+
+// Validate returns an error if any coin in coins fails DecCoin.Validate.
+func (coins DecCoins) Validate() error {
+	for _, coin := range coins {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//----------------------------------------
+
+// DecCoins is a sorted set of DecCoin, one per denom, mirroring Coins but
+// carrying fractional amounts.
+type DecCoins []DecCoin
+
+func (coins DecCoins) String() string {
+	if len(coins) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, coin := range coins {
+		out += fmt.Sprintf("%v,", coin.String())
+	}
+	return out[:len(out)-1]
+}
+
+// ParseDecCoins parses a comma separated list of "<amount><denom>" strings
+// into a sorted DecCoins.
+func ParseDecCoins(str string) (DecCoins, error) {
+	if len(str) == 0 {
+		return nil, nil
+	}
+
+	split := strings.Split(str, ",")
+	var coins DecCoins
+
+	for _, el := range split {
+		coin, err := ParseDecCoin(el)
+		if err != nil {
+			return coins, err
+		}
+		coins = append(coins, coin)
+	}
+
+	coins.Sort()
+	if !coins.IsValid() {
+		return nil, errors.Errorf("ParseDecCoins invalid: %#v", coins)
+	}
+
+	return coins, nil
+}
+
+// IsValid returns true if coins is sorted by denom, has no duplicate
+// denoms, and has no zero amounts.
+func (coins DecCoins) IsValid() bool {
+	switch len(coins) {
+	case 0:
+		return true
+	case 1:
+		return !coins[0].Amount.IsZero()
+	default:
+		lowDenom := coins[0].Denom
+		for _, coin := range coins[1:] {
+			if coin.Denom <= lowDenom {
+				return false
+			}
+			if coin.Amount.IsZero() {
+				return false
+			}
+			lowDenom = coin.Denom
+		}
+		return true
+	}
+}
+
+// Plus adds two sets of DecCoins, merging denoms and dropping any that sum
+// to zero.
+func (coinsA DecCoins) Plus(coinsB DecCoins) DecCoins {
+	sum := []DecCoin{}
+	indexA, indexB := 0, 0
+	lenA, lenB := len(coinsA), len(coinsB)
+	for {
+		if indexA == lenA {
+			if indexB == lenB {
+				return sum
+			}
+			return append(sum, coinsB[indexB:]...)
+		} else if indexB == lenB {
+			return append(sum, coinsA[indexA:]...)
+		}
+		coinA, coinB := coinsA[indexA], coinsB[indexB]
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1:
+			sum = append(sum, coinA)
+			indexA += 1
+		case 0:
+			sumAmount := coinA.Amount.Add(coinB.Amount)
+			if sumAmount.IsZero() {
+				// ignore 0 sum coin type
+			} else {
+				sum = append(sum, DecCoin{
+					Denom:  coinA.Denom,
+					Amount: sumAmount,
+				})
+			}
+			indexA += 1
+			indexB += 1
+		case 1:
+			sum = append(sum, coinB)
+			indexB += 1
+		}
+	}
+	return sum
+}
+
+// Negative returns a new DecCoins with every amount negated.
+func (coins DecCoins) Negative() DecCoins {
+	res := make([]DecCoin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.Neg(),
+		})
+	}
+	return res
+}
+
+// Minus subtracts coinsB from coinsA.
+func (coinsA DecCoins) Minus(coinsB DecCoins) DecCoins {
+	return coinsA.Plus(coinsB.Negative())
+}
+
+// IsGTE returns true if coinsA is greater than or equal to coinsB for every
+// denom present in coinsB.
+func (coinsA DecCoins) IsGTE(coinsB DecCoins) bool {
+	diff := coinsA.Minus(coinsB)
+	if len(diff) == 0 {
+		return true
+	}
+	return diff.IsNonnegative()
+}
+
+// IsZero returns true if coins is empty.
+func (coins DecCoins) IsZero() bool {
+	return len(coins) == 0
+}
+
+// IsEqual returns true if coinsA and coinsB contain the same denoms and
+// amounts, in the same order.
+func (coinsA DecCoins) IsEqual(coinsB DecCoins) bool {
+	if len(coinsA) != len(coinsB) {
+		return false
+	}
+	for i := 0; i < len(coinsA); i++ {
+		if coinsA[i].Denom != coinsB[i].Denom || !coinsA[i].Amount.Equal(coinsB[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsPositive returns true if there is at least one coin and all amounts are
+// positive.
+func (coins DecCoins) IsPositive() bool {
+	if len(coins) == 0 {
+		return false
+	}
+	for _, coin := range coins {
+		if !coin.Amount.IsPositive() {
+			return false
+		}
+	}
+	return true
+}
+
+// IsNonnegative returns true if all amounts are nonnegative.
+func (coins DecCoins) IsNonnegative() bool {
+	if len(coins) == 0 {
+		return true
+	}
+	for _, coin := range coins {
+		if coin.Amount.IsNegative() {
+			return false
+		}
+	}
+	return true
+}
+
+// TruncateDecimal splits coins into its integer part (as Coins) and its
+// fractional remainder (as DecCoins), so payout can happen on the integer
+// part without losing the residual.
+func (coins DecCoins) TruncateDecimal() (Coins, DecCoins) {
+	truncated := make(Coins, 0, len(coins))
+	remainder := make(DecCoins, 0, len(coins))
+
+	for _, coin := range coins {
+		whole := coin.Amount.TruncateInt()
+		frac := coin.Amount.Frac()
+
+		if !whole.IsZero() {
+			truncated = append(truncated, Coin{Denom: coin.Denom, Amount: whole})
+		}
+		if !frac.IsZero() {
+			remainder = append(remainder, DecCoin{Denom: coin.Denom, Amount: frac})
+		}
+	}
+
+	return truncated, remainder
+}
+
+/*** Implement Sort interface ***/
+
+func (c DecCoins) Len() int           { return len(c) }
+func (c DecCoins) Less(i, j int) bool { return c[i].Denom < c[j].Denom }
+func (c DecCoins) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c DecCoins) Sort()              { sort.Sort(c) }
@@ -0,0 +1,115 @@
+package types
+
+import "testing"
+
+func TestParseDecCoin(t *testing.T) {
+	cases := []struct {
+		input   string
+		denom   string
+		amount  string
+		wantErr bool
+	}{
+		{"1.5atom", "atom", "1.5", false},
+		{"10atom", "atom", "10", false},
+		{"1.5ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "1.5", false},
+		{"1.5factory/cosmos1abc/subdenom", "factory/cosmos1abc/subdenom", "1.5", false},
+		{"not-a-coin", "", "", true},
+		{"1.5a", "", "", true}, // denom too short
+	}
+
+	for _, tc := range cases {
+		coin, err := ParseDecCoin(tc.input)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseDecCoin(%q): expected error, got %v", tc.input, coin)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseDecCoin(%q): unexpected error %v", tc.input, err)
+			continue
+		}
+		wantAmt, _ := NewDecFromStr(tc.amount)
+		if coin.Denom != tc.denom || !coin.Amount.Equal(wantAmt) {
+			t.Errorf("ParseDecCoin(%q) = %v, want %s%s", tc.input, coin, tc.amount, tc.denom)
+		}
+	}
+}
+
+func TestDecCoinValidate(t *testing.T) {
+	if err := (DecCoin{Denom: "atom", Amount: NewDec(1)}).Validate(); err != nil {
+		t.Errorf("valid DecCoin rejected: %v", err)
+	}
+	if err := (DecCoin{Denom: "a", Amount: NewDec(1)}).Validate(); err == nil {
+		t.Errorf("expected error for too-short denom")
+	}
+}
+
+func TestNewDecCoinFromCoin(t *testing.T) {
+	coin := NewInt64Coin("atom", 10)
+	decCoin := NewDecCoinFromCoin(coin)
+
+	if decCoin.Denom != "atom" || !decCoin.Amount.Equal(NewDec(10)) {
+		t.Errorf("NewDecCoinFromCoin(%v) = %v", coin, decCoin)
+	}
+}
+
+func TestDecCoinsPlusMinus(t *testing.T) {
+	a, err := ParseDecCoins("1.5atom,2btc")
+	if err != nil {
+		t.Fatalf("ParseDecCoins: %v", err)
+	}
+	b, err := ParseDecCoins("0.5atom,2btc")
+	if err != nil {
+		t.Fatalf("ParseDecCoins: %v", err)
+	}
+
+	sum := a.Plus(b)
+	want, _ := ParseDecCoins("2atom,4btc")
+	if !sum.IsEqual(want) {
+		t.Errorf("1.5atom+2btc + 0.5atom+2btc = %v, want %v", sum, want)
+	}
+
+	// (a + b) - b should equal a.
+	roundTrip := a.Plus(b).Minus(b)
+	if !roundTrip.IsEqual(a) {
+		t.Errorf("(a + b) - b = %v, want %v", roundTrip, a)
+	}
+}
+
+func TestDecCoinsTruncateDecimal(t *testing.T) {
+	coins, err := ParseDecCoins("1.5atom,2btc")
+	if err != nil {
+		t.Fatalf("ParseDecCoins: %v", err)
+	}
+
+	whole, remainder := coins.TruncateDecimal()
+
+	if !whole.IsEqual(Coins{NewInt64Coin("atom", 1), NewInt64Coin("btc", 2)}) {
+		t.Errorf("whole = %v", whole)
+	}
+	wantFrac, _ := NewDecFromStr("0.5")
+	if len(remainder) != 1 || remainder[0].Denom != "atom" || !remainder[0].Amount.Equal(wantFrac) {
+		t.Errorf("remainder = %v", remainder)
+	}
+}
+
+func TestDecCoinsTruncateDecimalReconstructsNegativeAmount(t *testing.T) {
+	negated := DecCoins{NewDecCoinFromDec("atom", mustDec(t, "1.5").Neg())}
+	whole, remainder := negated.TruncateDecimal()
+
+	reconstructed := NewDecCoinFromCoin(whole[0]).Amount.Add(remainder[0].Amount)
+	want, _ := NewDecFromStr("-1.5")
+	if !reconstructed.Equal(want) {
+		t.Errorf("whole + remainder = %v, want %v (whole=%v, remainder=%v)", reconstructed, want, whole, remainder)
+	}
+}
+
+func mustDec(t *testing.T, s string) Dec {
+	t.Helper()
+	d, err := NewDecFromStr(s)
+	if err != nil {
+		t.Fatalf("NewDecFromStr(%q): %v", s, err)
+	}
+	return d
+}
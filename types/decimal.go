@@ -0,0 +1,187 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Precision is the number of decimal places a Dec keeps. Amounts are stored
+// internally as a big.Int scaled by 10^Precision, so arithmetic stays exact
+// arbitrary-precision integer math all the way down.
+const Precision = 18
+
+var precisionReuse = calcPrecisionMultiplier()
+
+func calcPrecisionMultiplier() *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(Precision), nil)
+}
+
+// Dec is a fixed-precision decimal backed by big.Int, used wherever an
+// amount needs to carry a fractional remainder (e.g. reward and fee
+// distribution) instead of truncating early.
+type Dec struct {
+	i *big.Int // value * 10^Precision
+}
+
+func (d Dec) assertNotNil() *big.Int {
+	if d.i == nil {
+		return new(big.Int)
+	}
+	return d.i
+}
+
+// ZeroDec returns a Dec representing 0.
+func ZeroDec() Dec { return Dec{new(big.Int)} }
+
+// NewDec constructs a Dec from a whole-number int64.
+func NewDec(n int64) Dec {
+	return Dec{new(big.Int).Mul(big.NewInt(n), precisionReuse)}
+}
+
+// NewDecFromInt constructs a Dec from a whole-number Int.
+func NewDecFromInt(i Int) Dec {
+	return Dec{new(big.Int).Mul(i.BigInt(), precisionReuse)}
+}
+
+// NewDecFromStr parses a decimal string (e.g. "1.5", "-0.000001") into a Dec,
+// truncating any digits beyond Precision decimal places.
+func NewDecFromStr(str string) (Dec, error) {
+	str = strings.TrimSpace(str)
+	if len(str) == 0 {
+		return Dec{}, errors.New("decimal string is empty")
+	}
+
+	neg := false
+	if str[0] == '-' {
+		neg = true
+		str = str[1:]
+	}
+
+	parts := strings.SplitN(str, ".", 2)
+	if len(parts) > 2 {
+		return Dec{}, errors.Errorf("invalid decimal: %s", str)
+	}
+
+	whole := parts[0]
+	if whole == "" {
+		whole = "0"
+	}
+	frac := ""
+	if len(parts) == 2 {
+		frac = parts[1]
+	}
+	if len(frac) > Precision {
+		frac = frac[:Precision]
+	} else {
+		frac = frac + strings.Repeat("0", Precision-len(frac))
+	}
+
+	combined, ok := new(big.Int).SetString(whole+frac, 10)
+	if !ok {
+		return Dec{}, errors.Errorf("invalid decimal: %s", str)
+	}
+	if neg {
+		combined.Neg(combined)
+	}
+	return Dec{combined}, nil
+}
+
+// IsZero returns true if d == 0.
+func (d Dec) IsZero() bool { return d.assertNotNil().Sign() == 0 }
+
+// IsPositive returns true if d > 0.
+func (d Dec) IsPositive() bool { return d.assertNotNil().Sign() == 1 }
+
+// IsNegative returns true if d < 0.
+func (d Dec) IsNegative() bool { return d.assertNotNil().Sign() == -1 }
+
+// IsNonnegative returns true if d >= 0.
+func (d Dec) IsNonnegative() bool { return d.assertNotNil().Sign() >= 0 }
+
+// Equal returns d == d2.
+func (d Dec) Equal(d2 Dec) bool { return d.assertNotNil().Cmp(d2.assertNotNil()) == 0 }
+
+// GT returns d > d2.
+func (d Dec) GT(d2 Dec) bool { return d.assertNotNil().Cmp(d2.assertNotNil()) == 1 }
+
+// GTE returns d >= d2.
+func (d Dec) GTE(d2 Dec) bool { return d.assertNotNil().Cmp(d2.assertNotNil()) >= 0 }
+
+// LT returns d < d2.
+func (d Dec) LT(d2 Dec) bool { return d.assertNotNil().Cmp(d2.assertNotNil()) == -1 }
+
+// LTE returns d <= d2.
+func (d Dec) LTE(d2 Dec) bool { return d.assertNotNil().Cmp(d2.assertNotNil()) <= 0 }
+
+// Add returns d + d2 as a new Dec.
+func (d Dec) Add(d2 Dec) Dec {
+	return Dec{new(big.Int).Add(d.assertNotNil(), d2.assertNotNil())}
+}
+
+// Sub returns d - d2 as a new Dec.
+func (d Dec) Sub(d2 Dec) Dec {
+	return Dec{new(big.Int).Sub(d.assertNotNil(), d2.assertNotNil())}
+}
+
+// Neg returns -d as a new Dec.
+func (d Dec) Neg() Dec {
+	return Dec{new(big.Int).Neg(d.assertNotNil())}
+}
+
+// TruncateInt returns the integer part of d, rounded towards negative
+// infinity (floor), as an Int. This is the counterpart to Frac: for any d,
+// d == TruncateInt(d) + Frac(d).
+func (d Dec) TruncateInt() Int {
+	return Int{new(big.Int).Div(d.assertNotNil(), precisionReuse)}
+}
+
+// Frac returns the fractional remainder of d after flooring, always
+// nonnegative, as a Dec in [0, 1). Because TruncateInt rounds towards
+// negative infinity rather than towards zero, TruncateInt(d) + Frac(d)
+// reconstructs d exactly, including for negative d.
+func (d Dec) Frac() Dec {
+	return Dec{new(big.Int).Mod(d.assertNotNil(), precisionReuse)}
+}
+
+// String implements the Stringer interface, rendering d as a decimal string
+// with exactly Precision digits after the point.
+func (d Dec) String() string {
+	bi := d.assertNotNil()
+	neg := bi.Sign() < 0
+	abs := new(big.Int).Abs(bi)
+
+	s := abs.String()
+	for len(s) <= Precision {
+		s = "0" + s
+	}
+	whole := s[:len(s)-Precision]
+	frac := s[len(s)-Precision:]
+
+	out := whole + "." + frac
+	if neg {
+		out = "-" + out
+	}
+	return out
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Dec) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Dec) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := NewDecFromStr(s)
+	if err != nil {
+		return err
+	}
+	d.i = parsed.i
+	return nil
+}
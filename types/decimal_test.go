@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDecFromStrRoundTrip(t *testing.T) {
+	// Dec.String() always renders Precision fractional digits, so round
+	// trip through NewDecFromStr again rather than comparing raw strings.
+	cases := []string{"0", "1", "1.5", "-1.5", "0.000000000000000001", "123456789.987654321"}
+	for _, str := range cases {
+		d, err := NewDecFromStr(str)
+		if err != nil {
+			t.Fatalf("NewDecFromStr(%q): %v", str, err)
+		}
+
+		reparsed, err := NewDecFromStr(d.String())
+		if err != nil {
+			t.Fatalf("NewDecFromStr(%q): %v", d.String(), err)
+		}
+		if !reparsed.Equal(d) {
+			t.Errorf("NewDecFromStr(%q).String() = %q, which reparses to %v, want %v", str, d.String(), reparsed, d)
+		}
+	}
+}
+
+func TestDecArithmeticIdentities(t *testing.T) {
+	a, _ := NewDecFromStr("1.5")
+	b, _ := NewDecFromStr("0.25")
+
+	if got, _ := NewDecFromStr("1.75"); !a.Add(b).Equal(got) {
+		t.Errorf("1.5 + 0.25 = %v, want 1.75", a.Add(b))
+	}
+	if got, _ := NewDecFromStr("1.25"); !a.Sub(b).Equal(got) {
+		t.Errorf("1.5 - 0.25 = %v, want 1.25", a.Sub(b))
+	}
+	if !a.Sub(a).IsZero() {
+		t.Errorf("1.5 - 1.5 should be zero")
+	}
+	if !a.Add(a.Neg()).IsZero() {
+		t.Errorf("1.5 + (-1.5) should be zero")
+	}
+}
+
+func TestDecJSONRoundTrip(t *testing.T) {
+	want, _ := NewDecFromStr("-123.456000000000000789")
+	bz, err := json.Marshal(want)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+
+	var got Dec
+	if err := json.Unmarshal(bz, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !got.Equal(want) {
+		t.Errorf("round trip %v -> %s -> %v", want, bz, got)
+	}
+}
+
+func TestDecTruncateIntAndFracReconstructOriginal(t *testing.T) {
+	cases := []string{"1.5", "0.5", "0", "-1.5", "-0.5", "-1", "1"}
+	for _, str := range cases {
+		d, err := NewDecFromStr(str)
+		if err != nil {
+			t.Fatalf("NewDecFromStr(%q): %v", str, err)
+		}
+
+		whole := d.TruncateInt()
+		frac := d.Frac()
+
+		if frac.IsNegative() {
+			t.Errorf("Frac(%s) = %v, want nonnegative", str, frac)
+		}
+
+		reconstructed := NewDecFromInt(whole).Add(frac)
+		if !reconstructed.Equal(d) {
+			t.Errorf("TruncateInt(%s) + Frac(%s) = %v, want %v", str, str, reconstructed, d)
+		}
+	}
+}
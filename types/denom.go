@@ -0,0 +1,59 @@
+package types
+
+import (
+	"regexp"
+
+	"github.com/pkg/errors"
+)
+
+// defaultDenomPattern accepts a leading lowercase letter followed by 2-127
+// characters from [a-zA-Z0-9/:._-] (total length 3-128). This covers
+// IBC-style paths (e.g. "ibc/27394FB0...") and factory denoms (e.g.
+// "factory/cosmos1.../subdenom") in addition to plain denoms like "atom".
+const defaultDenomPattern = `^[a-z][a-zA-Z0-9/:._-]{2,127}$`
+
+var reDenom = regexp.MustCompile(defaultDenomPattern)
+
+// SetDenomRegex overrides the pattern used by ValidateDenom, letting chains
+// tighten or loosen the default rule at init time.
+func SetDenomRegex(pattern string) {
+	reDenom = regexp.MustCompile(pattern)
+}
+
+// ValidateDenom checks that denom matches the configured denom regex,
+// returning a descriptive error naming the offending denom and, where
+// possible, the position of the first invalid character.
+func ValidateDenom(denom string) error {
+	if reDenom.MatchString(denom) {
+		return nil
+	}
+
+	if len(denom) < 3 || len(denom) > 128 {
+		return errors.Errorf("invalid denom %q: length %d is out of range [3, 128]", denom, len(denom))
+	}
+
+	for pos, r := range denom {
+		if !isValidDenomRune(r, pos) {
+			return errors.Errorf("invalid denom %q: invalid character %q at position %d", denom, r, pos)
+		}
+	}
+
+	return errors.Errorf("invalid denom %q: does not match %s", denom, reDenom.String())
+}
+
+// isValidDenomRune reports whether r is allowed at position pos under the
+// default denom charset. It is only used to produce a precise error
+// position and plays no part in the actual accept/reject decision, which
+// is always made by reDenom.
+func isValidDenomRune(r rune, pos int) bool {
+	if pos == 0 {
+		return r >= 'a' && r <= 'z'
+	}
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	case r == '/' || r == ':' || r == '.' || r == '_' || r == '-':
+		return true
+	}
+	return false
+}
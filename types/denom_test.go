@@ -0,0 +1,90 @@
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+func denomOfLen(n int) string {
+	return strings.Repeat("a", n)
+}
+
+func TestValidateDenomLengthBounds(t *testing.T) {
+	cases := []struct {
+		length  int
+		wantErr bool
+	}{
+		{2, true},
+		{3, false},
+		{128, false},
+		{129, true},
+	}
+
+	for _, tc := range cases {
+		err := ValidateDenom(denomOfLen(tc.length))
+		if tc.wantErr && err == nil {
+			t.Errorf("ValidateDenom(len=%d): expected error, got nil", tc.length)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("ValidateDenom(len=%d): unexpected error %v", tc.length, err)
+		}
+	}
+}
+
+func TestValidateDenomIBCAndFactoryStyles(t *testing.T) {
+	cases := []string{
+		"atom",
+		"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		"factory/cosmos1abcdefghijklmnop/subdenom",
+	}
+	for _, denom := range cases {
+		if err := ValidateDenom(denom); err != nil {
+			t.Errorf("ValidateDenom(%q): unexpected error %v", denom, err)
+		}
+	}
+}
+
+func TestValidateDenomCharacterPositionError(t *testing.T) {
+	err := ValidateDenom("atom!coin")
+	if err == nil {
+		t.Fatal("expected an error for a denom with an invalid character")
+	}
+	if !strings.Contains(err.Error(), "position 4") {
+		t.Errorf("error = %q, want it to name position 4 (the '!')", err.Error())
+	}
+}
+
+func TestValidateDenomLeadingCharacterMustBeLowercase(t *testing.T) {
+	err := ValidateDenom("Atom")
+	if err == nil {
+		t.Fatal("expected an error for a denom starting with an uppercase letter")
+	}
+	if !strings.Contains(err.Error(), "position 0") {
+		t.Errorf("error = %q, want it to name position 0 (the leading 'A')", err.Error())
+	}
+}
+
+func TestSetDenomRegex(t *testing.T) {
+	defer SetDenomRegex(defaultDenomPattern)
+
+	// Loosen the rule to accept a denom the default pattern would reject
+	// (leading digit).
+	SetDenomRegex(`^[a-zA-Z0-9]{2,16}$`)
+	if err := ValidateDenom("1ATOM"); err != nil {
+		t.Errorf("ValidateDenom under custom regex: unexpected error %v", err)
+	}
+	if err := ValidateDenom("atom"); err != nil {
+		t.Errorf("ValidateDenom under custom regex: unexpected error %v", err)
+	}
+
+	// A denom that satisfies the default pattern but not the custom one
+	// (too long) must now be rejected.
+	if err := ValidateDenom(denomOfLen(17)); err == nil {
+		t.Errorf("expected the custom regex to reject a 17-char denom")
+	}
+
+	SetDenomRegex(defaultDenomPattern)
+	if err := ValidateDenom("1ATOM"); err == nil {
+		t.Errorf("expected the default regex to reject a leading-digit denom after reset")
+	}
+}
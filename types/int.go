@@ -0,0 +1,141 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/pkg/errors"
+)
+
+// Int wraps math/big.Int, giving Coin amounts arbitrary precision so large
+// balances (genesis supply totals, airdrops, etc) can't silently overflow
+// the way a raw int64 would.
+type Int struct {
+	i *big.Int
+}
+
+// NewInt constructs an Int from an int64.
+func NewInt(n int64) Int {
+	return Int{big.NewInt(n)}
+}
+
+// NewIntFromBigInt constructs an Int from a *big.Int, copying it so the
+// caller can keep mutating their own reference safely.
+func NewIntFromBigInt(i *big.Int) Int {
+	if i == nil {
+		return ZeroInt()
+	}
+	return Int{new(big.Int).Set(i)}
+}
+
+// NewIntFromString parses a base-10 string into an Int, returning false if
+// the string is not a valid integer.
+func NewIntFromString(s string) (Int, bool) {
+	i, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Int{}, false
+	}
+	return Int{i}, true
+}
+
+// ZeroInt returns an Int representing 0.
+func ZeroInt() Int { return Int{big.NewInt(0)} }
+
+func (i Int) assertNotNil() *big.Int {
+	if i.i == nil {
+		return big.NewInt(0)
+	}
+	return i.i
+}
+
+// BigInt returns a copy of the underlying *big.Int.
+func (i Int) BigInt() *big.Int {
+	return new(big.Int).Set(i.assertNotNil())
+}
+
+// Int64 returns the int64 value of i, panicking if i is out of range.
+func (i Int) Int64() int64 {
+	bi := i.assertNotNil()
+	if !bi.IsInt64() {
+		panic("Int64() out of range")
+	}
+	return bi.Int64()
+}
+
+// IsZero returns true if i == 0.
+func (i Int) IsZero() bool { return i.assertNotNil().Sign() == 0 }
+
+// IsPositive returns true if i > 0.
+func (i Int) IsPositive() bool { return i.assertNotNil().Sign() == 1 }
+
+// IsNegative returns true if i < 0.
+func (i Int) IsNegative() bool { return i.assertNotNil().Sign() == -1 }
+
+// IsNonnegative returns true if i >= 0.
+func (i Int) IsNonnegative() bool { return i.assertNotNil().Sign() >= 0 }
+
+// Equal returns i == i2.
+func (i Int) Equal(i2 Int) bool { return i.assertNotNil().Cmp(i2.assertNotNil()) == 0 }
+
+// GT returns i > i2.
+func (i Int) GT(i2 Int) bool { return i.assertNotNil().Cmp(i2.assertNotNil()) == 1 }
+
+// GTE returns i >= i2.
+func (i Int) GTE(i2 Int) bool { return i.assertNotNil().Cmp(i2.assertNotNil()) >= 0 }
+
+// LT returns i < i2.
+func (i Int) LT(i2 Int) bool { return i.assertNotNil().Cmp(i2.assertNotNil()) == -1 }
+
+// LTE returns i <= i2.
+func (i Int) LTE(i2 Int) bool { return i.assertNotNil().Cmp(i2.assertNotNil()) <= 0 }
+
+// Add returns i + i2 as a new Int.
+func (i Int) Add(i2 Int) Int {
+	return Int{new(big.Int).Add(i.assertNotNil(), i2.assertNotNil())}
+}
+
+// Sub returns i - i2 as a new Int.
+func (i Int) Sub(i2 Int) Int {
+	return Int{new(big.Int).Sub(i.assertNotNil(), i2.assertNotNil())}
+}
+
+// Mul returns i * i2 as a new Int.
+func (i Int) Mul(i2 Int) Int {
+	return Int{new(big.Int).Mul(i.assertNotNil(), i2.assertNotNil())}
+}
+
+// Quo returns the integer quotient i / i2, truncated toward zero (matching
+// math/big.Int.Quo), as a new Int. This is only floor division when i and
+// i2 have the same sign; a mixed-sign quotient with a remainder is rounded
+// up toward zero, not down.
+func (i Int) Quo(i2 Int) Int {
+	return Int{new(big.Int).Quo(i.assertNotNil(), i2.assertNotNil())}
+}
+
+// Neg returns -i as a new Int.
+func (i Int) Neg() Int {
+	return Int{new(big.Int).Neg(i.assertNotNil())}
+}
+
+// String implements the Stringer interface.
+func (i Int) String() string { return i.assertNotNil().String() }
+
+// MarshalJSON implements the json.Marshaler interface, encoding the amount
+// as a decimal string so arbitrary precision survives the wire.
+func (i Int) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.assertNotNil().String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (i *Int) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	bi, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return errors.Errorf("Int.UnmarshalJSON: invalid integer string %q", s)
+	}
+	i.i = bi
+	return nil
+}
@@ -0,0 +1,133 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestIntArithmeticIdentities(t *testing.T) {
+	a := NewInt(17)
+	b := NewInt(5)
+
+	if !a.Add(b).Equal(NewInt(22)) {
+		t.Errorf("17 + 5 = %v, want 22", a.Add(b))
+	}
+	if !a.Sub(b).Equal(NewInt(12)) {
+		t.Errorf("17 - 5 = %v, want 12", a.Sub(b))
+	}
+	if !a.Mul(b).Equal(NewInt(85)) {
+		t.Errorf("17 * 5 = %v, want 85", a.Mul(b))
+	}
+	if !a.Quo(b).Equal(NewInt(3)) {
+		t.Errorf("17 / 5 = %v, want 3", a.Quo(b))
+	}
+	if !a.Sub(a).IsZero() {
+		t.Errorf("17 - 17 should be zero, got %v", a.Sub(a))
+	}
+	if !a.Add(a.Neg()).IsZero() {
+		t.Errorf("17 + (-17) should be zero, got %v", a.Add(a.Neg()))
+	}
+}
+
+func TestIntComparisons(t *testing.T) {
+	small := NewInt(3)
+	large := NewInt(9)
+
+	if !small.LT(large) || small.GT(large) {
+		t.Errorf("expected 3 < 9")
+	}
+	if !large.GT(small) || large.LT(small) {
+		t.Errorf("expected 9 > 3")
+	}
+	if !small.LTE(small) || !small.GTE(small) {
+		t.Errorf("expected 3 <= 3 and 3 >= 3")
+	}
+	if !small.Equal(NewInt(3)) {
+		t.Errorf("expected 3 == 3")
+	}
+
+	if !ZeroInt().IsZero() || ZeroInt().IsPositive() || ZeroInt().IsNegative() {
+		t.Errorf("ZeroInt() should be zero, nonpositive, nonnegative")
+	}
+	if !NewInt(-1).IsNegative() || NewInt(-1).IsNonnegative() {
+		t.Errorf("-1 should be negative")
+	}
+	if !NewInt(1).IsPositive() || !NewInt(1).IsNonnegative() {
+		t.Errorf("1 should be positive and nonnegative")
+	}
+}
+
+func TestIntOverflowBeyondInt64(t *testing.T) {
+	// 2^63, one past the max int64, must not overflow or lose precision.
+	huge, ok := NewIntFromString("9223372036854775808")
+	if !ok {
+		t.Fatal("expected NewIntFromString to parse a value beyond int64 range")
+	}
+	if huge.BigInt().Cmp(big.NewInt(9223372036854775807)) <= 0 {
+		t.Errorf("expected huge to exceed math.MaxInt64, got %v", huge)
+	}
+
+	doubled := huge.Add(huge)
+	want, _ := new(big.Int).SetString("18446744073709551616", 10)
+	if doubled.BigInt().Cmp(want) != 0 {
+		t.Errorf("huge + huge = %v, want %v", doubled, want)
+	}
+}
+
+func TestIntFromStringInvalid(t *testing.T) {
+	if _, ok := NewIntFromString("not-a-number"); ok {
+		t.Errorf("expected NewIntFromString to reject a non-numeric string")
+	}
+	if _, ok := NewIntFromString(""); ok {
+		t.Errorf("expected NewIntFromString to reject an empty string")
+	}
+}
+
+func TestIntInt64Panics(t *testing.T) {
+	huge, _ := NewIntFromString("100000000000000000000000000000")
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected Int64() to panic for a value out of int64 range")
+		}
+	}()
+	huge.Int64()
+}
+
+func TestIntJSONRoundTrip(t *testing.T) {
+	cases := []Int{
+		ZeroInt(),
+		NewInt(1),
+		NewInt(-1),
+		NewInt(1234567890),
+	}
+	huge, _ := NewIntFromString("123456789012345678901234567890")
+	cases = append(cases, huge)
+
+	for _, want := range cases {
+		bz, err := json.Marshal(want)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", want, err)
+		}
+
+		var got Int
+		if err := json.Unmarshal(bz, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", bz, err)
+		}
+		if !got.Equal(want) {
+			t.Errorf("round trip %v -> %s -> %v, want unchanged", want, bz, got)
+		}
+	}
+}
+
+func TestIntJSONIsDecimalString(t *testing.T) {
+	huge, _ := NewIntFromString("123456789012345678901234567890")
+	bz, err := json.Marshal(huge)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `"123456789012345678901234567890"`
+	if string(bz) != want {
+		t.Errorf("Marshal(huge) = %s, want %s", bz, want)
+	}
+}